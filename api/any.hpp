@@ -6,7 +6,9 @@
 #define __JULE_ANY_HPP
 
 #include <string>
+#ifndef __JULE_FREESTANDING
 #include <typeinfo>
+#endif
 #include <cstddef>
 #include <cstdlib>
 #include <ostream>
@@ -43,6 +43,12 @@ namespace jule
             std::fill(this->begin(), this->end(), def);
         }
 
+        // Copies elements of constant data table.
+        Array(const Item (&src)[static_cast<std::size_t>(N)])
+        {
+            std::copy(src, src + N, this->begin());
+        }
+
         using Iterator = Item *;
         using ConstIterator = const Item *;
 
@@ -102,6 +108,44 @@ namespace jule
             return slice;
         }
 
+        // Three-index slicing, capacity of result is cap-start.
+        jule::Slice<Item> slice(
+#ifndef __JULE_ENABLE__PRODUCTION
+            const char *file,
+#endif
+            const jule::Int &start,
+            const jule::Int &end,
+            const jule::Int &cap) const noexcept
+        {
+#ifndef __JULE_DISABLE__SAFETY
+            if (start < 0 || end < 0 || cap < 0 || start > end || end > cap || cap > N)
+            {
+                std::string error;
+                __JULE_WRITE_ERROR_CAP_SLICING_INDEX_OUT_OF_RANGE(error, start, end, cap, N);
+                error += "\nruntime: array slicing with out of range indexes";
+#ifndef __JULE_ENABLE__PRODUCTION
+                error += "\nfile: ";
+                error += file;
+#endif
+                jule::panic(error);
+            }
+#endif
+            if (start == cap)
+                return jule::Slice<Item>();
+
+            jule::Slice<Item> slice;
+            slice.alloc_new(0, cap - start);
+            slice._len = end - start;
+
+            Item *s_it = slice.begin();
+            jule::Array<Item, N>::ConstIterator a_it = this->begin() + start;
+            jule::Array<Item, N>::ConstIterator a_end = this->begin() + end;
+            while (a_it < a_end)
+                *s_it++ = *a_it++;
+
+            return slice;
+        }
+
         inline jule::Slice<Item> slice(
 #ifndef __JULE_ENABLE__PRODUCTION
             const char *file,
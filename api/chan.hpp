@@ -0,0 +1,217 @@
+// Copyright 2024 The Jule Programming Language.
+// Use of this source code is governed by a BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+#ifndef __JULE_CHAN_HPP
+#define __JULE_CHAN_HPP
+
+#include <condition_variable>
+#include <cstddef>
+#include <deque>
+#include <memory>
+#include <mutex>
+#include <ostream>
+#include <thread>
+
+#include "types.hpp"
+#include "panic.hpp"
+
+namespace jule
+{
+    // Built-in channel type.
+    // Directions of channels are checked at compile time,
+    // so all directions of channel are represented by this type.
+    template <typename T>
+    class Chan;
+
+    // Yields thread while waiting for cases of select statements.
+    inline void select_yield(void) noexcept;
+
+    template <typename T>
+    class Chan
+    {
+    private:
+        struct State
+        {
+            std::mutex mutex;
+            std::condition_variable cond;
+            std::deque<T> queue;
+            jule::Int cap = 0;
+            jule::U64 sent = 0;
+            jule::U64 received = 0;
+            jule::Int waiting_receivers = 0;
+            jule::Bool closed = false;
+        };
+
+        std::shared_ptr<jule::Chan<T>::State> state;
+
+        inline void check_nil(void) const noexcept
+        {
+#ifndef __JULE_DISABLE__SAFETY
+            if (this->state == nullptr)
+                jule::panic("runtime: chan[T]: operation on nil channel");
+#endif
+        }
+
+        inline void check_closed(void) const noexcept
+        {
+            if (this->state->closed)
+                jule::panic("runtime: chan[T]: send on closed channel");
+        }
+
+    public:
+        static jule::Chan<T> alloc(const jule::Int &cap) noexcept
+        {
+#ifndef __JULE_DISABLE__SAFETY
+            if (cap < 0)
+                jule::panic("runtime: chan[T]: channel buffer size lower than zero");
+#endif
+            jule::Chan<T> chan;
+            chan.state = std::make_shared<jule::Chan<T>::State>();
+            chan.state->cap = cap;
+            return chan;
+        }
+
+        Chan(void) = default;
+        Chan(std::nullptr_t) noexcept {}
+
+        // Sends value to channel.
+        // Waits for free space in buffer, and for receiver
+        // if channel is unbuffered. Panics if channel is closed.
+        void send(const T &value) const noexcept
+        {
+            this->check_nil();
+            std::unique_lock<std::mutex> lock(this->state->mutex);
+            const jule::Int cap = this->state->cap > 0 ? this->state->cap : 1;
+            this->state->cond.wait(lock, [&]
+                                   { return this->state->closed ||
+                                            static_cast<jule::Int>(this->state->queue.size()) < cap; });
+            this->check_closed();
+            this->state->queue.push_back(value);
+            const jule::U64 ticket = ++this->state->sent;
+            this->state->cond.notify_all();
+            if (this->state->cap == 0)
+                this->state->cond.wait(lock, [&]
+                                       { return this->state->closed || this->state->received >= ticket; });
+        }
+
+        // Receives value from channel.
+        // Waits until a value is sent. Values sent before closing
+        // are received first, then closed channel yields zero value.
+        T recv(void) const noexcept
+        {
+            this->check_nil();
+            std::unique_lock<std::mutex> lock(this->state->mutex);
+            ++this->state->waiting_receivers;
+            this->state->cond.notify_all();
+            this->state->cond.wait(lock, [&]
+                                   { return this->state->closed || !this->state->queue.empty(); });
+            --this->state->waiting_receivers;
+            if (this->state->queue.empty())
+                return T();
+            T value = this->state->queue.front();
+            this->state->queue.pop_front();
+            ++this->state->received;
+            this->state->cond.notify_all();
+            return value;
+        }
+
+        // Sends value if channel is ready to send without waiting.
+        // Nil channels are never ready.
+        jule::Bool try_send(const T &value) const noexcept
+        {
+            if (this->state == nullptr)
+                return false;
+            std::lock_guard<std::mutex> lock(this->state->mutex);
+            this->check_closed();
+            const jule::Int n = static_cast<jule::Int>(this->state->queue.size());
+            if (this->state->cap == 0)
+            {
+                // Unbuffered channel is ready if there is a waiting receiver.
+                if (n >= this->state->waiting_receivers)
+                    return false;
+            }
+            else if (n >= this->state->cap)
+                return false;
+            this->state->queue.push_back(value);
+            ++this->state->sent;
+            this->state->cond.notify_all();
+            return true;
+        }
+
+        // Receives value if channel is ready to receive without waiting.
+        // Received value is written to out if it is not nil.
+        // Nil channels are never ready, closed channels are always ready.
+        jule::Bool try_recv(T *out) const noexcept
+        {
+            if (this->state == nullptr)
+                return false;
+            std::lock_guard<std::mutex> lock(this->state->mutex);
+            if (this->state->queue.empty())
+            {
+                if (!this->state->closed)
+                    return false;
+                if (out != nullptr)
+                    *out = T();
+                return true;
+            }
+            if (out != nullptr)
+                *out = this->state->queue.front();
+            this->state->queue.pop_front();
+            ++this->state->received;
+            this->state->cond.notify_all();
+            return true;
+        }
+
+        // Closes channel and wakes up all waiting senders and receivers.
+        // Panics if channel is already closed.
+        void close(void) const noexcept
+        {
+            this->check_nil();
+            std::lock_guard<std::mutex> lock(this->state->mutex);
+#ifndef __JULE_DISABLE__SAFETY
+            if (this->state->closed)
+                jule::panic("runtime: chan[T]: close of closed channel");
+#endif
+            this->state->closed = true;
+            this->state->cond.notify_all();
+        }
+
+        inline jule::Bool operator==(const jule::Chan<T> &other) const noexcept
+        {
+            return this->state == other.state;
+        }
+
+        inline jule::Bool operator!=(const jule::Chan<T> &other) const noexcept
+        {
+            return !this->operator==(other);
+        }
+
+        inline jule::Bool operator==(std::nullptr_t) const noexcept
+        {
+            return this->state == nullptr;
+        }
+
+        inline jule::Bool operator!=(std::nullptr_t) const noexcept
+        {
+            return this->state != nullptr;
+        }
+
+        friend std::ostream &operator<<(std::ostream &stream,
+                                        const jule::Chan<T> &src) noexcept
+        {
+            if (src.state == nullptr)
+                stream << "<nil>";
+            else
+                stream << static_cast<void *>(src.state.get());
+            return stream;
+        }
+    };
+
+    inline void select_yield(void) noexcept
+    {
+        std::this_thread::yield();
+    }
+} // namespace jule
+
+#endif // ifndef __JULE_CHAN_HPP
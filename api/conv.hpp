@@ -0,0 +1,75 @@
+// Copyright 2024 The Jule Programming Language.
+// Use of this source code is governed by a BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+#ifndef __JULE_CONV_HPP
+#define __JULE_CONV_HPP
+
+#include <limits>
+#include <type_traits>
+
+#include "types.hpp"
+#include "opt.hpp"
+
+namespace jule
+{
+    // Reports whether integer x is in range of integer type T.
+    template <typename T, typename U>
+    constexpr jule::Bool int_fits(const U &x) noexcept;
+
+    // Converts integer x to integer type T.
+    // Clamps x to range of T if x overflows T.
+    template <typename T, typename U>
+    constexpr T saturating_cast(const U &x) noexcept;
+
+    // Converts integer x to integer type T.
+    // Truncates x to bits of T if x overflows T, in two's complement.
+    template <typename T, typename U>
+    constexpr T wrapping_cast(const U &x) noexcept;
+
+    // Converts integer x to integer type T.
+    // Returns nil if x overflows T.
+    template <typename T, typename U>
+    constexpr jule::Opt<T> checked_cast(const U &x) noexcept;
+
+    template <typename T, typename U>
+    constexpr jule::Bool int_fits(const U &x) noexcept
+    {
+        if constexpr (std::is_signed<U>::value == std::is_signed<T>::value)
+            return x >= std::numeric_limits<T>::min() && x <= std::numeric_limits<T>::max();
+        else if constexpr (std::is_signed<U>::value)
+            return x >= 0 &&
+                   static_cast<typename std::make_unsigned<U>::type>(x) <= std::numeric_limits<T>::max();
+        else
+            return x <= static_cast<typename std::make_unsigned<T>::type>(std::numeric_limits<T>::max());
+    }
+
+    template <typename T, typename U>
+    constexpr T saturating_cast(const U &x) noexcept
+    {
+        if (jule::int_fits<T>(x))
+            return static_cast<T>(x);
+        if constexpr (std::is_signed<U>::value)
+        {
+            if (x < 0)
+                return std::numeric_limits<T>::min();
+        }
+        return std::numeric_limits<T>::max();
+    }
+
+    template <typename T, typename U>
+    constexpr T wrapping_cast(const U &x) noexcept
+    {
+        return static_cast<T>(static_cast<typename std::make_unsigned<T>::type>(x));
+    }
+
+    template <typename T, typename U>
+    constexpr jule::Opt<T> checked_cast(const U &x) noexcept
+    {
+        if (!jule::int_fits<T>(x))
+            return nullptr;
+        return static_cast<T>(x);
+    }
+} // namespace jule
+
+#endif // ifndef __JULE_CONV_HPP
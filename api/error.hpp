@@ -12,6 +12,7 @@
 #define __JULE_ERROR__MEMORY_ALLOCATION_FAILED "memory allocation failed"
 #define __JULE_ERROR__INDEX_OUT_OF_RANGE "index out of range"
 #define __JULE_ERROR__DIVIDE_BY_ZERO "divide by zero"
+#define __JULE_ERROR__NEGATIVE_SHIFT "negative shift amount"
 
 #define __JULE_WRITE_ERROR_SLICING_INDEX_OUT_OF_RANGE(STR, START, END, LEN) \
     STR += __JULE_ERROR__INDEX_OUT_OF_RANGE " [";                           \
@@ -21,6 +22,16 @@
     STR += "] with length ";                                                \
     STR += std::to_string(LEN)
 
+#define __JULE_WRITE_ERROR_CAP_SLICING_INDEX_OUT_OF_RANGE(STR, START, END, CAP, LEN) \
+    STR += __JULE_ERROR__INDEX_OUT_OF_RANGE " [";                                      \
+    __jule_push_int_to_str(STR, START);                                                \
+    STR += ":";                                                                        \
+    __jule_push_int_to_str(STR, END);                                                  \
+    STR += ":";                                                                        \
+    __jule_push_int_to_str(STR, CAP);                                                  \
+    STR += "] with capacity ";                                                         \
+    STR += std::to_string(LEN)
+
 #define __JULE_WRITE_ERROR_INDEX_OUT_OF_RANGE(STR, INDEX, LEN) \
     STR += __JULE_ERROR__INDEX_OUT_OF_RANGE " [";              \
     __jule_push_int_to_str(STR, INDEX);                        \
@@ -5,6 +5,8 @@
 #ifndef __JULE_EXCEPTIONAL_HPP
 #define __JULE_EXCEPTIONAL_HPP
 
+#include <utility>
+
 #include "any.hpp"
 
 namespace jule
@@ -37,6 +39,7 @@ namespace jule
         Exceptional(void) = default;
         Exceptional(const jule::Any &error) : error(error) {}
         Exceptional(const jule::Any &error, const T &result) : error(error), result(result) {}
+        Exceptional(const jule::Any &error, T &&result) : error(error), result(std::move(result)) {}
 
         // Reports whether no exception.
         bool ok(void) const noexcept
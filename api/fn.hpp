@@ -19,6 +19,19 @@
 #define __JULE_CO(EXPR) \
     (__JULE_CO_SPAWN([=](void) mutable -> void { EXPR; }).detach())
 
+// Calling conventions of callback trampolines, see the callconv directive.
+// Calling conventions are distinct for 32-bit x86 only,
+// other architectures use the default calling convention.
+#if defined(__i386__)
+#define __JULE_CALLCONV_CDECL __attribute__((cdecl))
+#define __JULE_CALLCONV_STDCALL __attribute__((stdcall))
+#define __JULE_CALLCONV_FASTCALL __attribute__((fastcall))
+#else
+#define __JULE_CALLCONV_CDECL
+#define __JULE_CALLCONV_STDCALL
+#define __JULE_CALLCONV_FASTCALL
+#endif
+
 namespace jule
 {
 
@@ -7,10 +7,13 @@
 CONFIGURATION DEFINES
 
 - __JULE_ENABLE__PRODUCTION
+- __JULE_ENABLE__TRACE
 
 - __JULE_DISABLE__REFERENCE_COUNTING
 - __JULE_DISABLE__SAFETY
 
+- __JULE_FREESTANDING
+
 */
 
 #ifndef __JULE_HPP
@@ -22,6 +25,8 @@ CONFIGURATION DEFINES
 #include "array.hpp"
 #include "atomic.hpp"
 #include "builtin.hpp"
+#include "chan.hpp"
+#include "conv.hpp"
 #include "defer.hpp"
 #include "env.hpp"
 #include "error.hpp"
@@ -29,11 +34,13 @@ CONFIGURATION DEFINES
 #include "fn.hpp"
 #include "map.hpp"
 #include "misc.hpp"
+#include "opt.hpp"
 #include "panic.hpp"
 #include "platform.hpp"
 #include "ptr.hpp"
 #include "slice.hpp"
 #include "str.hpp"
+#include "trace.hpp"
 #include "trait.hpp"
 #include "types.hpp"
 #include "utf8.hpp"
@@ -19,6 +19,7 @@ namespace jule
     class MapKeyHasher;
 
     // Built-in map type.
+    // Iteration order is unspecified.
     template <typename Key, typename Value>
     class Map;
 
@@ -6,10 +6,12 @@
 #define __JULE_MISC_HPP
 
 #include <string>
+#include <type_traits>
 
 #include "error.hpp"
 #include "panic.hpp"
 #include "ptr.hpp"
+#include "types.hpp"
 
 namespace jule
 {
@@ -76,6 +78,99 @@ namespace jule
         {
                 return x % denominator;
         }
+
+        // Checked shifting for shift counts of runtime.
+        // Negative counts panic, counts which are not less than bit width
+        // of operand shifts all bits out instead of undefined behavior.
+        template <typename T, typename Count>
+        inline T shl(
+#ifndef __JULE_ENABLE__PRODUCTION
+            const char *file,
+#endif
+            const T &x, const Count &count) noexcept
+        {
+#ifndef __JULE_DISABLE__SAFETY
+                if (count < 0)
+                {
+#ifndef __JULE_ENABLE__PRODUCTION
+                        std::string error = __JULE_ERROR__NEGATIVE_SHIFT "\nruntime: negative shift count occurred when shifting\nfile: ";
+                        error += file;
+                        jule::panic(error);
+#else
+                        jule::panic(__JULE_ERROR__NEGATIVE_SHIFT "\nruntime: negative shift count occurred when shifting");
+#endif // PRODUCTION
+                }
+#endif // SAFETY
+                if (static_cast<jule::U64>(count) >= sizeof(T) << 3)
+                        return 0;
+                return static_cast<T>(x << count);
+        }
+
+        template <typename T, typename Count>
+        inline T shr(
+#ifndef __JULE_ENABLE__PRODUCTION
+            const char *file,
+#endif
+            const T &x, const Count &count) noexcept
+        {
+#ifndef __JULE_DISABLE__SAFETY
+                if (count < 0)
+                {
+#ifndef __JULE_ENABLE__PRODUCTION
+                        std::string error = __JULE_ERROR__NEGATIVE_SHIFT "\nruntime: negative shift count occurred when shifting\nfile: ";
+                        error += file;
+                        jule::panic(error);
+#else
+                        jule::panic(__JULE_ERROR__NEGATIVE_SHIFT "\nruntime: negative shift count occurred when shifting");
+#endif // PRODUCTION
+                }
+#endif // SAFETY
+                if (static_cast<jule::U64>(count) >= sizeof(T) << 3)
+                {
+                        // Sign bit fills all bits of signed integers.
+                        if (std::is_signed<T>::value && x < 0)
+                                return static_cast<T>(-1);
+                        return 0;
+                }
+                return static_cast<T>(x >> count);
+        }
+
+        template <typename T, typename Count>
+        inline void shl_assign(
+#ifndef __JULE_ENABLE__PRODUCTION
+            const char *file,
+#endif
+            T &x, const Count &count) noexcept
+        {
+#ifndef __JULE_ENABLE__PRODUCTION
+                x = jule::shl(file, x, count);
+#else
+                x = jule::shl(x, count);
+#endif
+        }
+
+        template <typename T, typename Count>
+        inline void shr_assign(
+#ifndef __JULE_ENABLE__PRODUCTION
+            const char *file,
+#endif
+            T &x, const Count &count) noexcept
+        {
+#ifndef __JULE_ENABLE__PRODUCTION
+                x = jule::shr(file, x, count);
+#else
+                x = jule::shr(x, count);
+#endif
+        }
+
+        // Reports whether all bits of flag are set in flags.
+        // Used for the Has method of flag enums.
+        template <typename T, typename Flag>
+        constexpr jule::Bool flags_has(const T &flags, const Flag &flag) noexcept
+        {
+                const T bits = static_cast<T>(flag);
+                return (flags & bits) == bits;
+        }
 } // namespace jule
 
 #endif // ifndef __JULE_MISC_HPP
@@ -0,0 +1,56 @@
+// Copyright 2024 The Jule Programming Language.
+// Use of this source code is governed by a BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+#ifndef __JULE_OPT_HPP
+#define __JULE_OPT_HPP
+
+#include <cstddef>
+#include <optional>
+#include <ostream>
+
+#include "types.hpp"
+
+namespace jule
+{
+    // Option type of Jule, represents ?T.
+    // Nil is the empty option, so nil literals are assignable and comparable.
+    template <typename T>
+    struct Opt : public std::optional<T>
+    {
+    public:
+        using std::optional<T>::optional;
+        using std::optional<T>::operator=;
+
+        Opt(void) = default;
+        Opt(std::nullptr_t) noexcept : std::optional<T>() {}
+
+        inline jule::Opt<T> &operator=(std::nullptr_t) noexcept
+        {
+            this->reset();
+            return *this;
+        }
+
+        constexpr jule::Bool operator==(std::nullptr_t) const noexcept
+        {
+            return !this->has_value();
+        }
+
+        constexpr jule::Bool operator!=(std::nullptr_t) const noexcept
+        {
+            return this->has_value();
+        }
+
+        friend inline std::ostream &operator<<(std::ostream &stream,
+                                               const jule::Opt<T> &opt) noexcept
+        {
+            if (!opt.has_value())
+                stream << "<nil>";
+            else
+                stream << *opt;
+            return stream;
+        }
+    };
+} // namespace jule
+
+#endif // ifndef __JULE_OPT_HPP
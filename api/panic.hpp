@@ -7,6 +7,7 @@
 
 #include <iostream>
 #include "impl_flag.hpp"
+#include "trace.hpp"
 
 #ifdef OS_WINDOWS
 #include "windows.h"
@@ -31,6 +32,9 @@ namespace jule
         WriteConsoleW(handle, utf16_str.data(), utf16_str.size(), nullptr, nullptr);
 #else
         std::cerr << expr << std::endl;
+#endif
+#ifdef __JULE_ENABLE__TRACE
+        jule::print_trace();
 #endif
         std::exit(jule::EXIT_PANIC);
         __builtin_unreachable();
@@ -70,6 +70,19 @@ namespace jule
             return slice;
         }
 
+        // Returns new slice which is copy of n elements of constant data table.
+        static jule::Slice<Item> make(const Item *src, const jule::Int &n)
+        {
+            if (n == 0)
+                return nullptr;
+
+            jule::Slice<Item> slice;
+            slice.alloc_new(n, n);
+            for (jule::Int i = 0; i < n; ++i)
+                slice.data.alloc[i] = src[i];
+            return slice;
+        }
+
         Slice(void) = default;
         Slice(const std::nullptr_t) : Slice() {}
 
@@ -247,6 +260,42 @@ namespace jule
             return slice;
         }
 
+        // Three-index slicing, capacity of result is cap-start.
+        inline Slice<Item> slice(
+#ifndef __JULE_ENABLE__PRODUCTION
+            const char *file,
+#endif
+            const jule::Int &start,
+            const jule::Int &end,
+            const jule::Int &cap) const noexcept
+        {
+#ifndef __JULE_DISABLE__SAFETY
+            if (start != 0 && cap != 0)
+                this->check(
+#ifndef __JULE_ENABLE__PRODUCTION
+                    file
+#endif
+                );
+            if (start < 0 || end < 0 || cap < 0 || start > end || end > cap || cap > this->_cap)
+            {
+                std::string error;
+                __JULE_WRITE_ERROR_CAP_SLICING_INDEX_OUT_OF_RANGE(error, start, end, cap, this->cap());
+                error += "\nruntime: slice slicing with out of range indexes";
+#ifndef __JULE_ENABLE__PRODUCTION
+                error += "\nfile: ";
+                error += file;
+#endif
+                jule::panic(error);
+            }
+#endif
+            jule::Slice<Item> slice;
+            slice.data = this->data;
+            slice._slice = this->_slice + start;
+            slice._len = end - start;
+            slice._cap = cap - start;
+            return slice;
+        }
+
         inline jule::Slice<Item> slice(
 #ifndef __JULE_ENABLE__PRODUCTION
             const char *file,
@@ -0,0 +1,64 @@
+// Copyright 2024 The Jule Programming Language.
+// Use of this source code is governed by a BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+#ifndef __JULE_TRACE_HPP
+#define __JULE_TRACE_HPP
+
+#include <iostream>
+
+namespace jule
+{
+    // Metadata of function for stack traces.
+    struct TraceFn
+    {
+        const char *name;
+        const char *file;
+        signed int line;
+    };
+
+    class TraceFrame;
+
+    // Innermost frame of stack trace of current thread.
+    thread_local jule::TraceFrame *trace_top = nullptr;
+
+    // Frame of stack trace.
+    // Constructed when function is entered and destructed when function
+    // returns, so frames are linked in reverse order of calls.
+    class TraceFrame
+    {
+    public:
+        const jule::TraceFn *fn;
+        jule::TraceFrame *caller;
+
+        TraceFrame(const jule::TraceFn *fn) noexcept : fn(fn), caller(jule::trace_top)
+        {
+            jule::trace_top = this;
+        }
+
+        TraceFrame(const jule::TraceFrame &) = delete;
+        jule::TraceFrame &operator=(const jule::TraceFrame &) = delete;
+
+        ~TraceFrame(void) noexcept
+        {
+            jule::trace_top = this->caller;
+        }
+    };
+
+    void print_trace(void) noexcept;
+
+    // Prints stack trace of current thread, innermost frame first.
+    void print_trace(void) noexcept
+    {
+        if (!jule::trace_top)
+            return;
+        std::cerr << "stack trace:" << std::endl;
+        for (const jule::TraceFrame *frame = jule::trace_top; frame; frame = frame->caller)
+        {
+            std::cerr << "    " << frame->fn->name << std::endl;
+            std::cerr << "        " << frame->fn->file << ":" << frame->fn->line << std::endl;
+        }
+    }
+} // namespace jule
+
+#endif // ifndef __JULE_TRACE_HPP
@@ -6,7 +6,9 @@
 #define __JULE_TRAIT_HPP
 
 #include <string>
+#ifndef __JULE_FREESTANDING
 #include <typeinfo>
+#endif
 #include <ostream>
 #include <cstring>
 
@@ -0,0 +1,19 @@
+// Copyright 2024 The Jule Programming Language.
+// Use of this source code is governed by a BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+#ifndef __JULEC_DAEMON_HPP
+#define __JULEC_DAEMON_HPP
+
+#include <random>
+
+// Fills buffer with n random bytes of nondeterministic source of system.
+void __julec_daemon_random(unsigned char *buf, long long n)
+{
+    std::random_device rd;
+    std::uniform_int_distribution<unsigned int> dist(0, 255);
+    for (long long i = 0; i < n; ++i)
+        buf[i] = static_cast<unsigned char>(dist(rd));
+}
+
+#endif // ifndef __JULEC_DAEMON_HPP
@@ -0,0 +1,63 @@
+// Copyright 2024 The Jule Programming Language.
+// Use of this source code is governed by a BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+#ifndef __JULEC_HANDLE_BUG_HPP
+#define __JULEC_HANDLE_BUG_HPP
+
+#include <csignal>
+#include <cstdio>
+#include <cstdlib>
+#include <iostream>
+#include <string>
+
+// Work in progress of compiler, printed at exit if not empty.
+// Runtime exits with std::exit after panic message, so exit handler
+// prints the work in progress after panic message. Crashes which are
+// not panics, such as segmentation faults, do not run exit handlers,
+// so fatal signals print the work in progress and re-raise the signal.
+// Compiler is never recovered, a crash terminates the whole process.
+// Regular exit paths of compiler should clear the work in progress
+// before exit.
+std::string __julec_bug_context;
+bool __julec_bug_handler_set = false;
+
+void __julec_bug_print_context(void) noexcept
+{
+    if (__julec_bug_context.empty())
+        return;
+#ifdef OS_WINDOWS
+    std::cerr << std::endl;
+#endif
+    std::cerr << __julec_bug_context << std::endl;
+}
+
+void __julec_bug_signal_handler(int sig) noexcept
+{
+    // Streams are not used, process may be in an inconsistent state.
+    if (!__julec_bug_context.empty())
+    {
+        std::fputs("\n", stderr);
+        std::fputs(__julec_bug_context.c_str(), stderr);
+        std::fputs("\n", stderr);
+        std::fflush(stderr);
+    }
+    std::signal(sig, SIG_DFL);
+    std::raise(sig);
+}
+
+void __julec_bug_set_context(const char *context) noexcept
+{
+    if (!__julec_bug_handler_set)
+    {
+        std::atexit(__julec_bug_print_context);
+        std::signal(SIGSEGV, __julec_bug_signal_handler);
+        std::signal(SIGABRT, __julec_bug_signal_handler);
+        std::signal(SIGFPE, __julec_bug_signal_handler);
+        std::signal(SIGILL, __julec_bug_signal_handler);
+        __julec_bug_handler_set = true;
+    }
+    __julec_bug_context = context;
+}
+
+#endif // ifndef __JULEC_HANDLE_BUG_HPP
@@ -37,4 +37,9 @@ __jule_thread_handle __jule_spawn_thread(const jule::Fn<void(void)> &routine) {
     return jth;
 }
 
+jule::Int __jule_hardware_concurrency(void) {
+    const unsigned int n = std::thread::hardware_concurrency();
+    return n == 0 ? 1 : static_cast<jule::Int>(n);
+}
+
 #endif // #ifndef __JULE_STD_THREAD_HPP
@@ -0,0 +1,23 @@
+// Copyright 2024 The Jule Programming Language.
+// Use of this source code is governed by a BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+#ifndef __JULE_TESTS_CALLBACK_HPP
+#define __JULE_TESTS_CALLBACK_HPP
+
+#include <cstdint>
+
+static std::int32_t apply(std::int32_t (*f)(std::int32_t), std::int32_t x)
+{
+    if (!f)
+        return -1;
+    return f(x);
+}
+
+static void each(void (*f)(std::int32_t), std::int32_t n)
+{
+    for (std::int32_t i = 0; i < n; ++i)
+        f(i);
+}
+
+#endif // __JULE_TESTS_CALLBACK_HPP
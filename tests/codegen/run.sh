@@ -0,0 +1,88 @@
+#!/bin/bash
+# Copyright 2024 The Jule Programming Language.
+# Use of this source code is governed by a BSD 3-Clause
+# license that can be found in the LICENSE file.
+
+# Golden-file tests of C++ code generation.
+#
+# Generates C++ code of the main function of each fixture and compares
+# it with the golden file of fixture, main.cpp.golden.
+# Compiler flags of fixture are read from the optional flags file of fixture.
+#
+# Flags;
+#  --update   Writes generated code to golden files instead of comparing.
+#  --compile  Also compiles and runs fixtures with the backend compiler.
+#
+# Environment;
+#  JULEC      Path of JuleC executable, default is julec.
+#  COMPILER   Backend compiler for the --compile flag, default is clang.
+
+JULEC=${JULEC:-julec}
+COMPILER=${COMPILER:-clang}
+UPDATE=0
+COMPILE=0
+
+for arg in "$@"; do
+  case $arg in
+    --update) UPDATE=1 ;;
+    --compile) COMPILE=1 ;;
+    *)
+      echo "error: unknown flag: $arg"
+      exit 1
+      ;;
+  esac
+done
+
+cd "$(dirname "$0")" || exit 1
+ROOT=$(pwd)
+FAILED=0
+TMP=$(mktemp -d)
+trap 'rm -rf "$TMP"' EXIT
+
+for fixture in fixtures/*/; do
+  fixture=${fixture%/}
+  name=$(basename "$fixture")
+  golden="$fixture/main.cpp.golden"
+  flags=""
+  if [ -f "$fixture/flags" ]; then
+    flags=$(cat "$fixture/flags")
+  fi
+
+  if ! "$JULEC" $flags --dump cpp --dump-fn main "$fixture" > "$TMP/$name.cpp" 2> "$TMP/$name.log"; then
+    echo "FAIL $name: code generation failed"
+    cat "$TMP/$name.log"
+    FAILED=1
+    continue
+  fi
+  # Location information has absolute paths,
+  # so make them relative to the test directory.
+  ROOT="$ROOT/" perl -pi -e 's/\Q$ENV{ROOT}\E//g' "$TMP/$name.cpp"
+  # Labels and some identifiers have addresses of compiler objects,
+  # so number them by order of appearance.
+  perl -pi -e 's/_([0-9a-f]{8,})/"_P".($m{$1}||=++$n)/ge' "$TMP/$name.cpp"
+
+  if [ $UPDATE -eq 1 ]; then
+    cp "$TMP/$name.cpp" "$golden"
+    echo "UPDATE $name"
+  elif [ ! -f "$golden" ]; then
+    echo "FAIL $name: golden file is not exist, run with --update"
+    FAILED=1
+    continue
+  elif ! diff -u "$golden" "$TMP/$name.cpp"; then
+    echo "FAIL $name: generated code differs from golden file"
+    FAILED=1
+    continue
+  fi
+
+  if [ $COMPILE -eq 1 ]; then
+    if ! (cd "$TMP" && "$JULEC" $flags --compiler "$COMPILER" -o "$name" "$ROOT/$fixture" && "./$name"); then
+      echo "FAIL $name: compilation or execution failed"
+      FAILED=1
+      continue
+    fi
+  fi
+
+  echo "PASS $name"
+done
+
+exit $FAILED